@@ -0,0 +1,97 @@
+// Package chans provides typed, synchronous channels on top of an
+// Engine, as a lighter weight alternative to Engine.Request for
+// request/response style protocols that don't need a fresh correlation
+// PID allocated per call.
+package chans
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+)
+
+// chanBufferSize is the capacity of the buffered Go channel backing a
+// ReceivePort. It is kept small and non-blocking on overflow (see
+// NewChan) rather than grown, since a typed channel is meant to carry
+// the latest value(s) a ReceivePort hasn't gotten to yet, not to apply
+// backpressure to the sender.
+const chanBufferSize = 1
+
+var chanSeq uint64
+
+// envelope tags a value with the ID of the channel it was sent on, so a
+// SendPort always reaches its matching ReceivePort.
+type envelope[T any] struct {
+	id    uint64
+	value T
+}
+
+// SendPort is the writable end of a typed channel created by NewChan.
+type SendPort[T any] struct {
+	engine *actor.Engine
+	pid    *actor.PID
+	id     uint64
+}
+
+// Send delivers v to the channel's ReceivePort.
+func (s SendPort[T]) Send(v T) {
+	s.engine.Send(s.pid, envelope[T]{id: s.id, value: v})
+}
+
+// ReceivePort is the readable end of a typed channel created by NewChan.
+type ReceivePort[T any] struct {
+	id uint64
+	ch chan T
+}
+
+// Receive blocks until a value arrives on the channel or ctx is done.
+// Shutting the backing process down does not unblock it.
+func (r ReceivePort[T]) Receive(ctx context.Context) (T, error) {
+	select {
+	case v := <-r.ch:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// NewChan spawns the hidden actor backing a typed channel and returns its
+// two ends. Only envelopes tagged with this channel's ID are ever handed
+// to the ReceivePort, so a single Engine can host many independent chans
+// of the same type without cross-talk.
+func NewChan[T any](e *actor.Engine) (SendPort[T], ReceivePort[T]) {
+	id := atomic.AddUint64(&chanSeq, 1)
+	ch := make(chan T, chanBufferSize)
+
+	pid := e.SpawnFunc(func(c *actor.Context) {
+		env, ok := c.Message().(envelope[T])
+		if !ok || env.id != id {
+			return
+		}
+		// ch must never be written to unconditionally: the process'
+		// context is only cancelled once this very handler returns, so
+		// an ordinary blocking send, or a select against
+		// c.Context().Done(), would both wedge this goroutine forever if
+		// the ReceivePort never catches up, which in turn wedges the
+		// process' whole message loop (it never gets back to
+		// nextEnvelope to pick up the poison pill that would otherwise
+		// stop it). Instead, drop the oldest buffered value to make room
+		// for the newest one if the ReceivePort hasn't drained it yet.
+		select {
+		case ch <- env.value:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- env.value:
+			default:
+			}
+		}
+	}, "chan")
+
+	return SendPort[T]{engine: e, pid: pid, id: id}, ReceivePort[T]{id: id, ch: ch}
+}
@@ -0,0 +1,86 @@
+package chans
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+)
+
+func TestChanSendReceive(t *testing.T) {
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, recv := NewChan[int](e)
+
+	send.Send(7)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	v, err := recv.Receive(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 7 {
+		t.Fatalf("got %d, want 7", v)
+	}
+}
+
+// TestChanDoesNotCrossTalk verifies two independent chans of the same
+// type, on the same engine, never deliver to each other's ReceivePort.
+func TestChanDoesNotCrossTalk(t *testing.T) {
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendA, recvA := NewChan[string](e)
+	sendB, recvB := NewChan[string](e)
+
+	sendA.Send("for-a")
+	sendB.Send("for-b")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	a, err := recvA.Receive(ctx)
+	if err != nil || a != "for-a" {
+		t.Fatalf("recvA: got (%q, %v), want (for-a, nil)", a, err)
+	}
+	b, err := recvB.Receive(ctx)
+	if err != nil || b != "for-b" {
+		t.Fatalf("recvB: got (%q, %v), want (for-b, nil)", b, err)
+	}
+}
+
+// TestChanUndrainedSendDoesNotWedgeShutdown exercises the deadlock this
+// package used to have: sending a second value before the first is
+// drained must not permanently block the hidden actor's goroutine, since
+// that would also block Engine.Shutdown from ever finishing.
+func TestChanUndrainedSendDoesNotWedgeShutdown(t *testing.T) {
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, _ := NewChan[int](e)
+
+	// Neither value is ever drained by a ReceivePort.Receive call.
+	send.Send(1)
+	send.Send(2)
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		done <- e.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Shutdown returned an error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Engine.Shutdown hung, the hidden actor is wedged on an undrained send")
+	}
+}
@@ -0,0 +1,95 @@
+package actor
+
+import "context"
+
+// Context is passed to every call of Receiver.Receive and carries
+// everything a receiver needs to know about the message it is currently
+// processing.
+type Context struct {
+	engine  *Engine
+	pid     *PID
+	sender  *PID
+	message any
+	proc    *process
+	values  map[string]any
+}
+
+func newContext(e *Engine, pid *PID) *Context {
+	return &Context{
+		engine: e,
+		pid:    pid,
+	}
+}
+
+// Engine returns the Engine this context, and the process it belongs to,
+// is running on.
+func (c *Context) Engine() *Engine { return c.engine }
+
+// Self returns the PID of the process that is currently handling the
+// message.
+func (c *Context) Self() *PID { return c.pid }
+
+// Sender returns the PID of the process that sent the current message,
+// or nil if it was sent without a sender.
+func (c *Context) Sender() *PID { return c.sender }
+
+// Message returns the message that is currently being processed.
+func (c *Context) Message() any { return c.message }
+
+// Send sends the given message to the given PID, using the current
+// process as the sender.
+func (c *Context) Send(pid *PID, msg any) {
+	c.engine.SendWithSender(pid, msg, c.pid)
+}
+
+// Forward forwards the message that is currently being handled to the
+// given PID, keeping the original sender intact.
+func (c *Context) Forward(pid *PID) {
+	c.engine.SendWithSender(pid, c.message, c.sender)
+}
+
+// WithValue returns a copy of the Context carrying the given key/value
+// pair. It is intended for use by ContextDecorators that need to attach
+// request-scoped data without mutating the Context shared by the rest of
+// the process.
+func (c *Context) WithValue(key string, value any) *Context {
+	clone := *c
+	clone.values = make(map[string]any, len(c.values)+1)
+	for k, v := range c.values {
+		clone.values[k] = v
+	}
+	clone.values[key] = value
+	return &clone
+}
+
+// Value returns the value previously attached with WithValue, or nil if
+// key was never set.
+func (c *Context) Value(key string) any {
+	if c.values == nil {
+		return nil
+	}
+	return c.values[key]
+}
+
+// Context returns the context tied to the lifetime of this process. It
+// is cancelled the moment the process exits, so a long-running goroutine
+// started from Receive can select on it to know when to stop.
+func (c *Context) Context() context.Context {
+	return c.proc.ctx
+}
+
+// Done returns the channel of the process' context, closed once the
+// process exits.
+func (c *Context) Done() <-chan struct{} {
+	return c.proc.ctx.Done()
+}
+
+// ReceiveSelected blocks the current process until a message matching sel
+// is available, ctx is done, or the process is stopped. Messages that do
+// not match are stashed on a saved queue and are the first to be handed
+// to Receive again, in the order they arrived, once selection completes.
+// It must only be called from within the Receive call of the process
+// that owns this Context.
+func (c *Context) ReceiveSelected(ctx context.Context, sel MessageSelector) (any, error) {
+	return c.proc.receiveSelected(ctx, sel)
+}
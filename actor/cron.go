@@ -0,0 +1,119 @@
+package actor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronExpr is a parsed standard 5-field cron expression: minute, hour,
+// day-of-month, month and day-of-week. Each field is a bitset of the
+// values that satisfy it; "*" sets every bit.
+type cronExpr struct {
+	minute, hour, dom, month, dow uint64
+	// domRestricted and dowRestricted record whether the day-of-month and
+	// day-of-week fields were given as anything other than "*". Standard
+	// cron semantics OR these two fields together when both are
+	// restricted, rather than ANDing them like every other field pair.
+	domRestricted, dowRestricted bool
+}
+
+func parseCron(expr string) (*cronExpr, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron: expected 5 fields, got %d in %q", len(fields), expr)
+	}
+	ranges := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+	bits := make([]uint64, 5)
+	for i, f := range fields {
+		b, err := parseCronField(f, ranges[i][0], ranges[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("cron: field %d (%q): %w", i, f, err)
+		}
+		bits[i] = b
+	}
+	return &cronExpr{
+		minute: bits[0], hour: bits[1], dom: bits[2], month: bits[3], dow: bits[4],
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		lo, hi, step := min, max, 1
+		switch {
+		case part == "*":
+			// lo/hi/step already cover the full range
+		case strings.HasPrefix(part, "*/"):
+			n, err := strconv.Atoi(part[2:])
+			if err != nil {
+				return 0, err
+			}
+			step = n
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			l, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, err
+			}
+			h, err := strconv.Atoi(bounds[1])
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = l, h
+		default:
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = n, n
+		}
+		if lo < min || hi > max {
+			return 0, fmt.Errorf("cron: value out of range [%d,%d]: %q", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// next returns the first minute-aligned instant strictly after from that
+// satisfies every field of the expression.
+func (c *cronExpr) next(from time.Time) time.Time {
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	// A cron schedule can't skip more than roughly 4 years before it
+	// matches again; bail out rather than loop forever on a bad
+	// expression.
+	limit := t.AddDate(4, 0, 0)
+	for t.Before(limit) {
+		if c.matches(t) {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
+	return time.Time{}
+}
+
+// matches reports whether t satisfies the expression. Every field is
+// ANDed together, except day-of-month and day-of-week: following
+// standard cron semantics, when both are restricted (not "*") they are
+// ORed instead, so "1st of the month, or every Monday" fires on either.
+func (c *cronExpr) matches(t time.Time) bool {
+	if !bitSet(c.minute, t.Minute()) || !bitSet(c.hour, t.Hour()) || !bitSet(c.month, int(t.Month())) {
+		return false
+	}
+	domMatch := bitSet(c.dom, t.Day())
+	dowMatch := bitSet(c.dow, int(t.Weekday()))
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+func bitSet(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
@@ -0,0 +1,67 @@
+package actor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronField(t *testing.T) {
+	cases := []struct {
+		field   string
+		min     int
+		max     int
+		wantErr bool
+	}{
+		{"*", 0, 59, false},
+		{"*/15", 0, 59, false},
+		{"1-5", 0, 59, false},
+		{"1,2,3", 0, 59, false},
+		{"24", 0, 23, true},   // out of range, e.g. someone meaning midnight
+		{"0-24", 0, 23, true}, // range overruns max
+		{"nope", 0, 59, true},
+	}
+	for _, c := range cases {
+		_, err := parseCronField(c.field, c.min, c.max)
+		if (err != nil) != c.wantErr {
+			t.Errorf("parseCronField(%q, %d, %d): err = %v, wantErr = %v", c.field, c.min, c.max, err, c.wantErr)
+		}
+	}
+}
+
+func TestParseCronFieldCount(t *testing.T) {
+	if _, err := parseCron("* * *"); err == nil {
+		t.Fatal("expected an error for a cron expression with too few fields")
+	}
+}
+
+// TestCronDomDowOr verifies standard cron semantics: when both
+// day-of-month and day-of-week are restricted, they are ORed together
+// rather than ANDed, so "1st of the month, or every Monday" fires on
+// whichever comes first.
+func TestCronDomDowOr(t *testing.T) {
+	c, err := parseCron("0 0 1 * 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC) // a Saturday
+	got := c.next(from)
+	want := time.Date(2026, time.August, 3, 0, 0, 0, 0, time.UTC) // the next Monday
+	if !got.Equal(want) {
+		t.Fatalf("next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+// TestCronDomDowAndWhenOneUnrestricted checks the ordinary case still
+// behaves like a plain AND when only one of dom/dow is restricted.
+func TestCronDomDowAndWhenOneUnrestricted(t *testing.T) {
+	c, err := parseCron("0 0 15 * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC)
+	got := c.next(from)
+	want := time.Date(2026, time.August, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("next(%v) = %v, want %v", from, got, want)
+	}
+}
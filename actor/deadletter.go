@@ -0,0 +1,19 @@
+package actor
+
+// DeadLetterEvent is broadcast over the eventstream whenever a message
+// could not be delivered because its target PID is not registered with
+// the Engine.
+type DeadLetterEvent struct {
+	Target  *PID
+	Message any
+	Sender  *PID
+}
+
+// EngineRemoteMissingEvent is broadcast over the eventstream whenever a
+// message is addressed to a remote PID but the Engine has no Remoter
+// configured.
+type EngineRemoteMissingEvent struct {
+	Target  *PID
+	Message any
+	Sender  *PID
+}
@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -16,6 +17,10 @@ type Remoter interface {
 	Send(*PID, any, *PID)
 	Start(*Engine) error
 	Stop() *sync.WaitGroup
+	// SendSystem delivers an internal system message, such as a
+	// ProcessTerminated notification from the monitor/link subsystem, to
+	// a process living on another node.
+	SendSystem(pid *PID, sysmsg any)
 }
 
 // Producer is any function that can return a Receiver
@@ -32,11 +37,24 @@ type Engine struct {
 	address     string
 	remote      Remoter
 	eventStream *PID
+	monitors    *monitorState
+	middleware  []ReceiveMiddleware
+	names       *nameRegistry
+	scheduler   *scheduler
+	// nameServerAddr is the address of the hollywood-namesrv sidecar this
+	// engine talks to for cluster-wide name resolution, set through
+	// EngineConfig.WithNameServer.
+	nameServerAddr string
+	// shuttingDown is flipped by Shutdown to stop accepting new Send
+	// calls while the engine drains its processes.
+	shuttingDown atomic.Bool
 }
 
 // EngineConfig holds the configuration of the engine.
 type EngineConfig struct {
-	remote Remoter
+	remote         Remoter
+	middleware     []ReceiveMiddleware
+	nameServerAddr string
 }
 
 // NewEngineConfig returns a new default EngineConfig.
@@ -51,11 +69,32 @@ func (config EngineConfig) WithRemote(remote Remoter) EngineConfig {
 	return config
 }
 
+// WithReceiveMiddleware sets middleware that is prepended to the chain of
+// every process spawned on the engine, including system actors such as
+// the eventstream.
+func (config EngineConfig) WithReceiveMiddleware(mw ...ReceiveMiddleware) EngineConfig {
+	config.middleware = append(config.middleware, mw...)
+	return config
+}
+
+// WithNameServer points the engine at a hollywood-namesrv sidecar, used
+// to publish names registered with RegisterName and to resolve names on
+// other nodes through WhereIsRemote.
+func (config EngineConfig) WithNameServer(addr string) EngineConfig {
+	config.nameServerAddr = addr
+	return config
+}
+
 // NewEngine returns a new actor Engine given an EngineConfig.
 func NewEngine(config EngineConfig) (*Engine, error) {
 	e := &Engine{}
 	e.Registry = newRegistry(e) // need to init the registry in case we want a custom deadletter
+	e.monitors = newMonitorState()
+	e.middleware = config.middleware
+	e.names = newNameRegistry()
+	e.nameServerAddr = config.nameServerAddr
 	e.address = LocalLookupAddr
+	e.scheduler = newScheduler(e)
 	if config.remote != nil {
 		e.remote = config.remote
 		e.address = config.remote.Address()
@@ -71,6 +110,14 @@ func NewEngine(config EngineConfig) (*Engine, error) {
 // Spawn spawns a process that will producer by the given Producer and
 // can be configured with the given opts.
 func (e *Engine) Spawn(p Producer, kind string, opts ...OptFunc) *PID {
+	return e.SpawnCtx(context.Background(), p, kind, opts...)
+}
+
+// SpawnCtx behaves like Spawn, but threads ctx through to the spawned
+// process. The process' context is cancelled as soon as it exits, which
+// lets long-running goroutines started from Receive select on
+// Context.Done() to know when to stop.
+func (e *Engine) SpawnCtx(ctx context.Context, p Producer, kind string, opts ...OptFunc) *PID {
 	options := DefaultOpts(p)
 	options.Kind = kind
 	for _, opt := range opts {
@@ -82,6 +129,7 @@ func (e *Engine) Spawn(p Producer, kind string, opts ...OptFunc) *PID {
 		options.ID = id
 	}
 	proc := newProcess(e, options)
+	proc.ctx, proc.cancel = context.WithCancel(ctx)
 	return e.SpawnProc(proc)
 }
 
@@ -120,6 +168,9 @@ func (e *Engine) Request(pid *PID, msg any, timeout time.Duration) *Response {
 // given sender. Receivers receiving this message can check the sender
 // by calling Context.Sender().
 func (e *Engine) SendWithSender(pid *PID, msg any, sender *PID) {
+	if e.shuttingDown.Load() {
+		return
+	}
 	e.send(pid, msg, sender)
 }
 
@@ -127,9 +178,59 @@ func (e *Engine) SendWithSender(pid *PID, msg any, sender *PID) {
 // delivered due to the fact that the given process is not registered.
 // The message will be sent to the DeadLetter process instead.
 func (e *Engine) Send(pid *PID, msg any) {
+	if e.shuttingDown.Load() {
+		return
+	}
 	e.send(pid, msg, nil)
 }
 
+// Shutdown stops the Engine. It stops accepting new Send calls, poisons
+// every registered process in reverse spawn order (so that processes
+// spawned later, typically children, drain before the ones that spawned
+// them), waits for the Remoter to drain its own connections, and finally
+// closes the eventstream. If ctx is cancelled before a process finishes
+// draining its inbox, that process is escalated into a hard Stop.
+func (e *Engine) Shutdown(ctx context.Context) error {
+	if !e.shuttingDown.CompareAndSwap(false, true) {
+		return fmt.Errorf("engine is already shutting down")
+	}
+	e.scheduler.stop()
+
+	for _, pid := range e.Registry.pids() {
+		if pid.Equals(e.eventStream) {
+			continue
+		}
+		e.drain(ctx, pid)
+	}
+
+	if e.remote != nil {
+		e.remote.Stop().Wait()
+	}
+
+	e.drain(ctx, e.eventStream)
+	return ctx.Err()
+}
+
+// drain poisons pid gracefully, escalating to a hard Stop if ctx is
+// cancelled before the process finishes draining its inbox. It does not
+// wait for the hard Stop to complete: ctx being done means Shutdown's
+// caller is no longer willing to wait, and a process that ignores its
+// own poison pill (e.g. one blocked inside Receive without selecting on
+// Context.Done()) can't be forced to return control, so waiting here
+// could hang just as long as the graceful drain would have.
+func (e *Engine) drain(ctx context.Context, pid *PID) {
+	done := e.PoisonCtx(ctx, pid)
+	select {
+	case <-done.Done():
+	case <-ctx.Done():
+	}
+	// done is derived from ctx, so both cases are ready once ctx is
+	// cancelled; a process still registered at that point has not drained.
+	if ctx.Err() != nil && e.Registry.get(pid) != nil {
+		e.Stop(pid)
+	}
+}
+
 // BroadcastEvent will broadcast the given message over the eventstream, notifying all
 // actors that are subscribed.
 func (e *Engine) BroadcastEvent(msg any) {
@@ -157,52 +258,28 @@ func (e *Engine) send(pid *PID, msg any, sender *PID) {
 	e.remote.Send(pid, msg, sender)
 }
 
-// SendRepeater is a struct that can be used to send a repeating message to a given PID.
-// If you need to have an actor wake up periodically, you can use a SendRepeater.
-// It is started by the SendRepeat method and stopped by it's Stop() method.
+// SendRepeater is kept for backwards compatibility with SendRepeat; it is
+// now a thin wrapper around a ScheduleHandle driven by the Engine's
+// internal scheduler instead of its own goroutine and ticker.
 type SendRepeater struct {
-	engine   *Engine
-	self     *PID
-	target   *PID
-	msg      any
-	interval time.Duration
-	cancelch chan struct{}
-}
-
-func (sr SendRepeater) start() {
-	ticker := time.NewTicker(sr.interval)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				sr.engine.SendWithSender(sr.target, sr.msg, sr.self)
-			case <-sr.cancelch:
-				ticker.Stop()
-				return
-			}
-		}
-	}()
+	handle ScheduleHandle
 }
 
 // Stop will stop the repeating message.
 func (sr SendRepeater) Stop() {
-	close(sr.cancelch)
+	sr.handle.Stop()
 }
 
 // SendRepeat will send the given message to the given PID each given interval.
 // It will return a SendRepeater struct that can stop the repeating message by calling Stop().
+//
+// SendRepeat remains only as a thin wrapper around ScheduleSend with a
+// FixedInterval schedule; new code should prefer calling ScheduleSend
+// directly, which also supports jitter, exponential backoff, cron
+// expressions and one-shot sends.
 func (e *Engine) SendRepeat(pid *PID, msg any, interval time.Duration) SendRepeater {
-	clonedPID := *pid.CloneVT()
-	sr := SendRepeater{
-		engine:   e,
-		self:     nil,
-		target:   &clonedPID,
-		interval: interval,
-		msg:      msg,
-		cancelch: make(chan struct{}, 1),
-	}
-	sr.start()
-	return sr
+	handle := e.ScheduleSend(pid, msg, FixedInterval{D: interval})
+	return SendRepeater{handle: handle}
 }
 
 // Stop will send a non-graceful poisonPill message to the process that is associated with the given PID.
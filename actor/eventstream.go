@@ -0,0 +1,47 @@
+package actor
+
+import "sync"
+
+// eventSub subscribes the given PID to the eventstream.
+type eventSub struct {
+	pid *PID
+}
+
+// eventUnsub unsubscribes the given PID from the eventstream.
+type eventUnsub struct {
+	pid *PID
+}
+
+// eventStream is the built-in actor that fans out every BroadcastEvent
+// call to its subscribers.
+type eventStream struct {
+	mu   sync.RWMutex
+	subs map[string]*PID
+}
+
+func newEventStream() Producer {
+	return func() Receiver {
+		return &eventStream{
+			subs: make(map[string]*PID),
+		}
+	}
+}
+
+func (es *eventStream) Receive(c *Context) {
+	switch msg := c.Message().(type) {
+	case eventSub:
+		es.mu.Lock()
+		es.subs[msg.pid.String()] = msg.pid
+		es.mu.Unlock()
+	case eventUnsub:
+		es.mu.Lock()
+		delete(es.subs, msg.pid.String())
+		es.mu.Unlock()
+	default:
+		es.mu.RLock()
+		defer es.mu.RUnlock()
+		for _, pid := range es.subs {
+			c.engine.SendWithSender(pid, msg, c.Sender())
+		}
+	}
+}
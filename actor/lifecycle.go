@@ -0,0 +1,40 @@
+package actor
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// DefaultDrainDeadline is the deadline RunUntilSignal gives the engine to
+// shut down gracefully before escalating any remaining processes into a
+// hard Stop.
+const DefaultDrainDeadline = 30 * time.Second
+
+// RunUntilSignal blocks until one of the given OS signals is received,
+// then shuts the engine down, allowing DefaultDrainDeadline for it to
+// drain gracefully. Use RunUntilSignalCtx to customize the drain
+// deadline or to supply a parent context.
+func RunUntilSignal(e *Engine, sig ...os.Signal) error {
+	return RunUntilSignalCtx(context.Background(), e, DefaultDrainDeadline, sig...)
+}
+
+// RunUntilSignalCtx behaves like RunUntilSignal, but lets the caller pick
+// the drain deadline passed to Engine.Shutdown and provide a parent
+// context that can itself be cancelled to interrupt the wait early.
+func RunUntilSignalCtx(ctx context.Context, e *Engine, drainDeadline time.Duration, sig ...os.Signal) error {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig...)
+	defer signal.Stop(ch)
+
+	select {
+	case <-ch:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, drainDeadline)
+	defer cancel()
+	return e.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,42 @@
+package actor
+
+// ReceiveMiddleware wraps a Receiver.Receive call with cross-cutting
+// behavior such as tracing, logging, metrics, panic recovery or retries.
+// Middlewares are composed once when a process is activated and the
+// resulting chain is invoked for every message the process handles.
+type ReceiveMiddleware func(next func(*Context)) func(*Context)
+
+// ContextDecorator can enrich or replace the Context a message is
+// delivered with. Decorators run before the first ReceiveMiddleware in
+// the chain, in the order they were given.
+type ContextDecorator func(*Context) *Context
+
+// WithMiddleware appends the given middlewares to the process being
+// spawned. They run after any middleware configured globally on the
+// Engine via EngineConfig.WithReceiveMiddleware.
+func WithMiddleware(mw ...ReceiveMiddleware) OptFunc {
+	return func(opts *Opts) {
+		opts.Middleware = append(opts.Middleware, mw...)
+	}
+}
+
+// WithContextDecorator appends the given decorators to the process being
+// spawned.
+func WithContextDecorator(dec ...ContextDecorator) OptFunc {
+	return func(opts *Opts) {
+		opts.ContextDecorator = append(opts.ContextDecorator, dec...)
+	}
+}
+
+// buildHandler composes the engine-global middleware with the process'
+// own middleware into a single chain, with base invoking the receiver.
+func buildHandler(base func(*Context), engineMW, processMW []ReceiveMiddleware) func(*Context) {
+	chain := base
+	for i := len(processMW) - 1; i >= 0; i-- {
+		chain = processMW[i](chain)
+	}
+	for i := len(engineMW) - 1; i >= 0; i-- {
+		chain = engineMW[i](chain)
+	}
+	return chain
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics returns a ReceiveMiddleware that records the processing
+// duration of every message, labeled by its Go type, as a histogram
+// registered on registerer.
+func Metrics(registerer prometheus.Registerer) actor.ReceiveMiddleware {
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "hollywood_receive_duration_seconds",
+		Help: "Duration of Receiver.Receive calls, labeled by message type.",
+	}, []string{"message"})
+	registerer.MustRegister(histogram)
+
+	return func(next func(*actor.Context)) func(*actor.Context) {
+		return func(c *actor.Context) {
+			start := time.Now()
+			next(c)
+			histogram.WithLabelValues(fmt.Sprintf("%T", c.Message())).Observe(time.Since(start).Seconds())
+		}
+	}
+}
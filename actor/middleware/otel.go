@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry returns a ReceiveMiddleware that starts a span named after
+// the message's Go type for every message a process handles, using the
+// given tracer. The span is started from the process' own context, so it
+// inherits whatever trace was active when the process was spawned, and is
+// ended once the rest of the chain returns.
+func OpenTelemetry(tracer trace.Tracer) actor.ReceiveMiddleware {
+	return func(next func(*actor.Context)) func(*actor.Context) {
+		return func(c *actor.Context) {
+			spanName := fmt.Sprintf("%T", c.Message())
+			_, span := tracer.Start(c.Context(), spanName)
+			defer span.End()
+			next(c)
+		}
+	}
+}
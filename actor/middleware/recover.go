@@ -0,0 +1,24 @@
+// Package middleware ships a handful of ReceiveMiddleware implementations
+// that are generally useful enough to live next to the actor package
+// instead of in every consumer's codebase.
+package middleware
+
+import "github.com/pnkj-kmr/hollywood/actor"
+
+// Recover returns a ReceiveMiddleware that recovers from a panic raised
+// while handling a message and hands it to handler instead of letting it
+// crash the process. Use this when a crash-and-restart semantics (driven
+// through Engine.Monitor / Engine.Link) is not what you want for a given
+// actor.
+func Recover(handler func(c *actor.Context, reason any)) actor.ReceiveMiddleware {
+	return func(next func(*actor.Context)) func(*actor.Context) {
+		return func(c *actor.Context) {
+			defer func() {
+				if r := recover(); r != nil {
+					handler(c, r)
+				}
+			}()
+			next(c)
+		}
+	}
+}
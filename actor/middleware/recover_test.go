@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+)
+
+func TestRecoverStopsPanicFromCrashingTheProcess(t *testing.T) {
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovered := make(chan any, 1)
+	alive := make(chan struct{}, 1)
+
+	pid := e.SpawnFunc(func(c *actor.Context) {
+		if c.Message() == "boom" {
+			panic("boom")
+		}
+		alive <- struct{}{}
+	}, "recovering", actor.WithMiddleware(Recover(func(c *actor.Context, reason any) {
+		recovered <- reason
+	})))
+
+	e.Send(pid, "boom")
+	select {
+	case r := <-recovered:
+		if r != "boom" {
+			t.Fatalf("got %#v, want \"boom\"", r)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the panic to be recovered")
+	}
+
+	// The process must still be alive, not crashed, after the panic.
+	e.Send(pid, "still there?")
+	select {
+	case <-alive:
+	case <-time.After(time.Second):
+		t.Fatal("process did not survive the recovered panic")
+	}
+}
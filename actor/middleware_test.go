@@ -0,0 +1,74 @@
+package actor
+
+import (
+	"testing"
+)
+
+// traceStep returns a ReceiveMiddleware that reports name+":before" and
+// name+":after" over steps as it wraps next, so tests can observe
+// composition order without a data race on a shared slice.
+func traceStep(steps chan any, name string) ReceiveMiddleware {
+	return func(next func(*Context)) func(*Context) {
+		return func(c *Context) {
+			steps <- name + ":before"
+			next(c)
+			steps <- name + ":after"
+		}
+	}
+}
+
+func TestMiddlewareOrderEngineBeforeProcess(t *testing.T) {
+	steps := make(chan any, 8)
+	config := NewEngineConfig().WithReceiveMiddleware(traceStep(steps, "engine"))
+	e, err := NewEngine(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pid := e.SpawnFunc(func(c *Context) {
+		steps <- "base"
+	}, "traced", WithMiddleware(traceStep(steps, "process")))
+
+	e.Send(pid, "go")
+
+	want := []string{"engine:before", "process:before", "base", "process:after", "engine:after"}
+	for _, w := range want {
+		if got := expectMessage(t, steps); got != w {
+			t.Fatalf("got %v, want %v", got, w)
+		}
+	}
+}
+
+func TestContextDecoratorRunsBeforeMiddleware(t *testing.T) {
+	steps := make(chan any, 8)
+	decorator := func(c *Context) *Context {
+		steps <- "decorator"
+		return c.WithValue("decorated", true)
+	}
+	mw := func(next func(*Context)) func(*Context) {
+		return func(c *Context) {
+			if c.Value("decorated") != true {
+				t.Error("middleware ran without seeing the decorator's value")
+			}
+			steps <- "middleware"
+			next(c)
+		}
+	}
+
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := e.SpawnFunc(func(c *Context) {
+		steps <- "base"
+	}, "decorated", WithContextDecorator(decorator), WithMiddleware(mw))
+
+	e.Send(pid, "go")
+
+	want := []string{"decorator", "middleware", "base"}
+	for _, w := range want {
+		if got := expectMessage(t, steps); got != w {
+			t.Fatalf("got %v, want %v", got, w)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package actor
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TerminationReason describes why a ProcessTerminated notification was
+// delivered.
+type TerminationReason uint8
+
+const (
+	// TerminationNormal means the process shut down after a Stop or
+	// Poison call.
+	TerminationNormal TerminationReason = iota
+	// TerminationCrashed means the process panicked while handling a
+	// message.
+	TerminationCrashed
+	// NoProc means the monitored or linked PID was never registered
+	// with the Engine.
+	NoProc
+)
+
+func (r TerminationReason) String() string {
+	switch r {
+	case TerminationNormal:
+		return "normal"
+	case TerminationCrashed:
+		return "crashed"
+	case NoProc:
+		return "noproc"
+	default:
+		return "unknown"
+	}
+}
+
+// MonitorRef is an opaque handle returned by Engine.Monitor, identifying
+// both the monitor and the node it was created on so termination
+// notifications resolve correctly once they cross the wire.
+type MonitorRef struct {
+	node string
+	id   uint64
+}
+
+// ProcessTerminated is delivered straight into a watcher's mailbox,
+// bypassing the eventstream, so a selective receive can pick it out of
+// the inbox without racing every other subscriber.
+type ProcessTerminated struct {
+	PID    *PID
+	Reason TerminationReason
+	Ref    MonitorRef
+}
+
+// monitorState tracks monitor and link relations, keyed by the string
+// representation of the target/peer PID.
+type monitorState struct {
+	mu       sync.Mutex
+	seq      uint64
+	monitors map[string]map[MonitorRef]*PID
+	links    map[string]map[string]*PID
+}
+
+func newMonitorState() *monitorState {
+	return &monitorState{
+		monitors: make(map[string]map[MonitorRef]*PID),
+		links:    make(map[string]map[string]*PID),
+	}
+}
+
+// Monitor registers watcher to receive a ProcessTerminated message once
+// target terminates, whether by a graceful Poison, a hard Stop, or a
+// panic inside Receive. If target is not currently registered, the
+// notification is delivered immediately with Reason set to NoProc.
+func (e *Engine) Monitor(watcher, target *PID) MonitorRef {
+	e.monitors.mu.Lock()
+	e.monitors.seq++
+	ref := MonitorRef{node: e.address, id: e.monitors.seq}
+	if e.Registry.get(target) == nil {
+		e.monitors.mu.Unlock()
+		e.deliverTermination(watcher, ProcessTerminated{PID: target, Reason: NoProc, Ref: ref})
+		return ref
+	}
+	key := target.String()
+	if e.monitors.monitors[key] == nil {
+		e.monitors.monitors[key] = make(map[MonitorRef]*PID)
+	}
+	e.monitors.monitors[key][ref] = watcher
+	e.monitors.mu.Unlock()
+	return ref
+}
+
+// Demonitor removes a monitor previously installed with Monitor. It is a
+// no-op if the ref is unknown or already fired.
+func (e *Engine) Demonitor(ref MonitorRef) {
+	e.monitors.mu.Lock()
+	defer e.monitors.mu.Unlock()
+	for key, refs := range e.monitors.monitors {
+		if _, ok := refs[ref]; !ok {
+			continue
+		}
+		delete(refs, ref)
+		if len(refs) == 0 {
+			delete(e.monitors.monitors, key)
+		}
+		return
+	}
+}
+
+// Link bidirectionally links a and b: when either one terminates, the
+// other is cascaded into a Stop (on a crash) or a Poison (on a normal
+// shutdown), unless the peer was spawned WithTrapExit(true), in which
+// case it receives a ProcessTerminated message instead of being shut
+// down.
+func (e *Engine) Link(a, b *PID) {
+	e.monitors.mu.Lock()
+	defer e.monitors.mu.Unlock()
+	e.addLinkLocked(a, b)
+	e.addLinkLocked(b, a)
+}
+
+func (e *Engine) addLinkLocked(from, to *PID) {
+	key := from.String()
+	if e.monitors.links[key] == nil {
+		e.monitors.links[key] = make(map[string]*PID)
+	}
+	e.monitors.links[key][to.String()] = to
+}
+
+// Unlink removes the bidirectional link between a and b.
+func (e *Engine) Unlink(a, b *PID) {
+	e.monitors.mu.Lock()
+	defer e.monitors.mu.Unlock()
+	if peers := e.monitors.links[a.String()]; peers != nil {
+		delete(peers, b.String())
+	}
+	if peers := e.monitors.links[b.String()]; peers != nil {
+		delete(peers, a.String())
+	}
+}
+
+// handleProcessStopped is invoked by a process once its poison pill has
+// been fully handled.
+func (e *Engine) handleProcessStopped(pid *PID, graceful bool) {
+	e.notifyTerminated(pid, TerminationNormal)
+}
+
+// handleProcessCrash is invoked from the recover() in process.run when a
+// Receiver panics while handling a message.
+func (e *Engine) handleProcessCrash(pid *PID, reason any) {
+	e.BroadcastEvent(fmt.Errorf("%s crashed: %v", pid, reason))
+	e.notifyTerminated(pid, TerminationCrashed)
+}
+
+func (e *Engine) notifyTerminated(pid *PID, reason TerminationReason) {
+	e.Registry.remove(pid)
+	e.names.onTerminated(pid)
+
+	e.monitors.mu.Lock()
+	key := pid.String()
+	watchers := e.monitors.monitors[key]
+	delete(e.monitors.monitors, key)
+	peers := e.monitors.links[key]
+	delete(e.monitors.links, key)
+	e.monitors.mu.Unlock()
+
+	for ref, watcher := range watchers {
+		e.deliverTermination(watcher, ProcessTerminated{PID: pid, Reason: reason, Ref: ref})
+	}
+	for _, peer := range peers {
+		e.cascadeLink(peer, pid, reason)
+	}
+}
+
+func (e *Engine) cascadeLink(peer, from *PID, reason TerminationReason) {
+	e.Unlink(peer, from)
+	if e.trapExit(peer) {
+		e.deliverTermination(peer, ProcessTerminated{PID: from, Reason: reason})
+		return
+	}
+	if reason == TerminationCrashed {
+		e.Stop(peer)
+		return
+	}
+	e.Poison(peer)
+}
+
+func (e *Engine) trapExit(pid *PID) bool {
+	proc, ok := e.Registry.get(pid).(*process)
+	return ok && proc.TrapExit
+}
+
+// deliverTermination delivers a ProcessTerminated notification directly
+// to pid's mailbox, over the Remoter if pid lives on another node.
+func (e *Engine) deliverTermination(pid *PID, msg ProcessTerminated) {
+	if e.isLocalMessage(pid) {
+		e.SendLocal(pid, msg, nil)
+		return
+	}
+	if e.remote != nil {
+		e.remote.SendSystem(pid, msg)
+	}
+}
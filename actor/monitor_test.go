@@ -0,0 +1,159 @@
+package actor
+
+import (
+	"testing"
+	"time"
+)
+
+// collector spawns a process that forwards every message it receives
+// onto a channel, so tests can assert on what was delivered to it.
+func collector(t *testing.T, e *Engine, opts ...OptFunc) (*PID, chan any) {
+	t.Helper()
+	ch := make(chan any, 16)
+	pid := e.SpawnFunc(func(c *Context) {
+		ch <- c.Message()
+	}, "collector", opts...)
+	return pid, ch
+}
+
+func expectMessage(t *testing.T, ch chan any) any {
+	t.Helper()
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return nil
+	}
+}
+
+func expectNoMessage(t *testing.T, ch chan any) {
+	t.Helper()
+	select {
+	case msg := <-ch:
+		t.Fatalf("expected no message, got %#v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMonitorNormalTermination(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, _ := collector(t, e)
+	watcher, watcherCh := collector(t, e)
+
+	e.Monitor(watcher, target)
+	<-e.Poison(target).Done()
+
+	msg := expectMessage(t, watcherCh)
+	term, ok := msg.(ProcessTerminated)
+	if !ok {
+		t.Fatalf("expected ProcessTerminated, got %#v", msg)
+	}
+	if !term.PID.Equals(target) || term.Reason != TerminationNormal {
+		t.Fatalf("unexpected termination: %#v", term)
+	}
+}
+
+func TestMonitorCrash(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := e.SpawnFunc(func(c *Context) {
+		panic("boom")
+	}, "crasher")
+	watcher, watcherCh := collector(t, e)
+
+	e.Monitor(watcher, target)
+	e.Send(target, "trigger")
+
+	msg := expectMessage(t, watcherCh)
+	term, ok := msg.(ProcessTerminated)
+	if !ok || term.Reason != TerminationCrashed {
+		t.Fatalf("expected a crashed ProcessTerminated, got %#v", msg)
+	}
+}
+
+func TestMonitorNoProc(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher, watcherCh := collector(t, e)
+	ghost := NewPID(e.Address(), "ghost/1")
+
+	e.Monitor(watcher, ghost)
+
+	msg := expectMessage(t, watcherCh)
+	term, ok := msg.(ProcessTerminated)
+	if !ok || term.Reason != NoProc {
+		t.Fatalf("expected an immediate NoProc ProcessTerminated, got %#v", msg)
+	}
+}
+
+func TestDemonitorSuppressesNotification(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, _ := collector(t, e)
+	watcher, watcherCh := collector(t, e)
+
+	ref := e.Monitor(watcher, target)
+	e.Demonitor(ref)
+	<-e.Poison(target).Done()
+
+	expectNoMessage(t, watcherCh)
+}
+
+func TestLinkCascadesNormalShutdown(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := collector(t, e)
+	b, _ := collector(t, e)
+
+	// Watch b so we can observe it being cascaded into a Poison once a
+	// shuts down, without racing the cascade itself.
+	watcher, watcherCh := collector(t, e)
+	e.Monitor(watcher, b)
+
+	e.Link(a, b)
+	<-e.Poison(a).Done()
+
+	msg := expectMessage(t, watcherCh)
+	term, ok := msg.(ProcessTerminated)
+	if !ok || !term.PID.Equals(b) || term.Reason != TerminationNormal {
+		t.Fatalf("expected b to be cascaded into a normal termination, got %#v", msg)
+	}
+}
+
+func TestLinkTrapExitDeliversNotificationInstead(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := collector(t, e)
+	b, bCh := collector(t, e, WithTrapExit(true))
+
+	e.Link(a, b)
+	<-e.Poison(a).Done()
+
+	msg := expectMessage(t, bCh)
+	term, ok := msg.(ProcessTerminated)
+	if !ok || !term.PID.Equals(a) {
+		t.Fatalf("expected b to receive a ProcessTerminated for a, got %#v", msg)
+	}
+
+	// b should still be alive since it trapped the exit instead of being
+	// shut down along with a.
+	e.Send(b, "still alive")
+	msg = expectMessage(t, bCh)
+	if msg != "still alive" {
+		t.Fatalf("expected b to still be running, got %#v", msg)
+	}
+}
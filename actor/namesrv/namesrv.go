@@ -0,0 +1,205 @@
+// Package namesrv implements the server side of the mapper protocol
+// spoken by an Engine configured with EngineConfig.WithNameServer. It is
+// a small gossiping registry, inspired by quacktors' qpmd: every
+// instance keeps the full (node, name) -> PID table and periodically
+// exchanges it with its peers, so a cluster can resolve names without
+// any single instance being a hard dependency.
+package namesrv
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+)
+
+// GossipInterval is how often a Registry pushes its table to its peers.
+const GossipInterval = 2 * time.Second
+
+// binding is one (node, name) -> PID entry. Conflicts between registries
+// are resolved with last-writer-wins over UpdatedAt, a wall-clock
+// timestamp; OriginID only breaks ties between two updates stamped in
+// the same nanosecond. A bare local counter can't do this job: two
+// registries each keep their own counter, so if the same (node, name)
+// is ever registered against two different sidecars there is no way to
+// tell whose count is actually newer.
+type binding struct {
+	PID       *actor.PID `json:"pid"`
+	UpdatedAt int64      `json:"updated_at"`
+	OriginID  string     `json:"origin_id"`
+	// Deleted marks a tombstoned binding. It carries the same
+	// UpdatedAt/OriginID stamp as any other update, so an unregister
+	// survives gossip instead of losing to a peer's stale copy of the
+	// same (node, name) that was never told about the delete.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// newer reports whether a should replace b under last-writer-wins.
+func newer(a, b binding) bool {
+	if a.UpdatedAt != b.UpdatedAt {
+		return a.UpdatedAt > b.UpdatedAt
+	}
+	return a.OriginID > b.OriginID
+}
+
+// Registry is a gossiping (node, name) -> PID table.
+type Registry struct {
+	mu       sync.RWMutex
+	bindings map[string]map[string]binding // node -> name -> binding
+	id       string                        // stamped on every local update as OriginID
+	peers    []string
+	client   *http.Client
+}
+
+// NewRegistry returns a Registry that will gossip its table to the given
+// peer addresses every GossipInterval.
+func NewRegistry(peers ...string) *Registry {
+	return &Registry{
+		bindings: make(map[string]map[string]binding),
+		id:       newOriginID(),
+		peers:    peers,
+		client:   &http.Client{Timeout: GossipInterval},
+	}
+}
+
+func newOriginID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Handler returns the http.Handler implementing the mapper protocol:
+// POST /register, POST /unregister, GET /whereis and POST /gossip.
+func (r *Registry) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/register", r.handleRegister)
+	mux.HandleFunc("/unregister", r.handleUnregister)
+	mux.HandleFunc("/whereis", r.handleWhereIs)
+	mux.HandleFunc("/gossip", r.handleGossip)
+	return mux
+}
+
+// Run starts gossiping to peers in a loop until ctx-like stop channel is
+// closed. Callers typically run it in its own goroutine next to
+// http.ListenAndServe(addr, registry.Handler()).
+func (r *Registry) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(GossipInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.gossip()
+		case <-stop:
+			return
+		}
+	}
+}
+
+type wireBinding struct {
+	Node      string     `json:"node"`
+	Name      string     `json:"name"`
+	PID       *actor.PID `json:"pid"`
+	UpdatedAt int64      `json:"updated_at"`
+	OriginID  string     `json:"origin_id"`
+	Deleted   bool       `json:"deleted,omitempty"`
+}
+
+func (r *Registry) handleRegister(w http.ResponseWriter, req *http.Request) {
+	var b wireBinding
+	if err := json.NewDecoder(req.Body).Decode(&b); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.mu.Lock()
+	r.setLocked(b.Node, b.Name, binding{PID: b.PID, UpdatedAt: time.Now().UnixNano(), OriginID: r.id})
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Registry) handleUnregister(w http.ResponseWriter, req *http.Request) {
+	var b wireBinding
+	if err := json.NewDecoder(req.Body).Decode(&b); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.mu.Lock()
+	r.setLocked(b.Node, b.Name, binding{UpdatedAt: time.Now().UnixNano(), OriginID: r.id, Deleted: true})
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Registry) handleWhereIs(w http.ResponseWriter, req *http.Request) {
+	node := req.URL.Query().Get("node")
+	name := req.URL.Query().Get("name")
+
+	r.mu.RLock()
+	b, ok := r.bindings[node][name]
+	r.mu.RUnlock()
+	if !ok || b.Deleted {
+		http.NotFound(w, req)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(wireBinding{Node: node, Name: name, PID: b.PID, UpdatedAt: b.UpdatedAt, OriginID: b.OriginID})
+}
+
+func (r *Registry) handleGossip(w http.ResponseWriter, req *http.Request) {
+	var incoming []wireBinding
+	if err := json.NewDecoder(req.Body).Decode(&incoming); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.mu.Lock()
+	for _, b := range incoming {
+		candidate := binding{PID: b.PID, UpdatedAt: b.UpdatedAt, OriginID: b.OriginID, Deleted: b.Deleted}
+		existing, ok := r.bindings[b.Node][b.Name]
+		if ok && !newer(candidate, existing) {
+			continue
+		}
+		r.setLocked(b.Node, b.Name, candidate)
+	}
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Registry) setLocked(node, name string, b binding) {
+	names, ok := r.bindings[node]
+	if !ok {
+		names = make(map[string]binding)
+		r.bindings[node] = names
+	}
+	names[name] = b
+}
+
+func (r *Registry) snapshot() []wireBinding {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]wireBinding, 0)
+	for node, names := range r.bindings {
+		for name, b := range names {
+			out = append(out, wireBinding{Node: node, Name: name, PID: b.PID, UpdatedAt: b.UpdatedAt, OriginID: b.OriginID, Deleted: b.Deleted})
+		}
+	}
+	return out
+}
+
+func (r *Registry) gossip() {
+	body, err := json.Marshal(r.snapshot())
+	if err != nil {
+		log.Printf("namesrv: failed to marshal gossip payload: %v", err)
+		return
+	}
+	for _, peer := range r.peers {
+		resp, err := r.client.Post("http://"+peer+"/gossip", "application/json", bytes.NewReader(body))
+		if err != nil {
+			log.Printf("namesrv: gossip to %s failed: %v", peer, err)
+			continue
+		}
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,144 @@
+package namesrv
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pnkj-kmr/hollywood/actor"
+)
+
+func mustPost(t *testing.T, srv *httptest.Server, path string, v any) *http.Response {
+	t.Helper()
+	body, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(srv.URL+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func whereIs(t *testing.T, srv *httptest.Server, node, name string) *wireBinding {
+	t.Helper()
+	resp, err := http.Get(srv.URL + "/whereis?node=" + node + "&name=" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("whereis returned %s", resp.Status)
+	}
+	var b wireBinding
+	if err := json.NewDecoder(resp.Body).Decode(&b); err != nil {
+		t.Fatal(err)
+	}
+	return &b
+}
+
+func TestRegisterUnregisterWhereIsRoundTrip(t *testing.T) {
+	r := NewRegistry()
+	srv := httptest.NewServer(r.Handler())
+	defer srv.Close()
+
+	pid := actor.NewPID("node-a", "worker/1")
+	resp := mustPost(t, srv, "/register", wireBinding{Node: "node-a", Name: "worker", PID: pid})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("register returned %s", resp.Status)
+	}
+
+	got := whereIs(t, srv, "node-a", "worker")
+	if got == nil || !got.PID.Equals(pid) {
+		t.Fatalf("whereis = %v, want %v", got, pid)
+	}
+
+	resp = mustPost(t, srv, "/unregister", wireBinding{Node: "node-a", Name: "worker"})
+	resp.Body.Close()
+
+	if got := whereIs(t, srv, "node-a", "worker"); got != nil {
+		t.Fatalf("whereis after unregister = %v, want nil", got)
+	}
+}
+
+func TestGossipNewerWins(t *testing.T) {
+	r := NewRegistry()
+	srv := httptest.NewServer(r.Handler())
+	defer srv.Close()
+
+	stale := actor.NewPID("node-a", "worker/1")
+	fresh := actor.NewPID("node-a", "worker/2")
+
+	resp := mustPost(t, srv, "/gossip", []wireBinding{
+		{Node: "node-a", Name: "worker", PID: stale, UpdatedAt: 100, OriginID: "aaaa"},
+	})
+	resp.Body.Close()
+
+	// An older update for the same binding must not overwrite the newer one.
+	resp = mustPost(t, srv, "/gossip", []wireBinding{
+		{Node: "node-a", Name: "worker", PID: fresh, UpdatedAt: 50, OriginID: "bbbb"},
+	})
+	resp.Body.Close()
+	if got := whereIs(t, srv, "node-a", "worker"); got == nil || !got.PID.Equals(stale) {
+		t.Fatalf("whereis = %v, want the newer (by UpdatedAt) binding %v to have stuck", got, stale)
+	}
+
+	resp = mustPost(t, srv, "/gossip", []wireBinding{
+		{Node: "node-a", Name: "worker", PID: fresh, UpdatedAt: 200, OriginID: "bbbb"},
+	})
+	resp.Body.Close()
+	if got := whereIs(t, srv, "node-a", "worker"); got == nil || !got.PID.Equals(fresh) {
+		t.Fatalf("whereis = %v, want the newer binding %v to have replaced the stale one", got, fresh)
+	}
+}
+
+func TestGossipTiesBrokenByOriginID(t *testing.T) {
+	a := actor.NewPID("node-a", "worker/a")
+	b := actor.NewPID("node-a", "worker/b")
+
+	if !newer(binding{PID: b, UpdatedAt: 10, OriginID: "zzzz"}, binding{PID: a, UpdatedAt: 10, OriginID: "aaaa"}) {
+		t.Fatal("expected the binding with the larger OriginID to win a tied UpdatedAt")
+	}
+	if newer(binding{PID: a, UpdatedAt: 10, OriginID: "aaaa"}, binding{PID: b, UpdatedAt: 10, OriginID: "zzzz"}) {
+		t.Fatal("expected the binding with the smaller OriginID to lose a tied UpdatedAt")
+	}
+}
+
+// TestTombstoneSurvivesStaleGossip verifies that once a binding has been
+// unregistered (tombstoned), a peer's gossip of its old, pre-delete copy
+// does not resurrect it.
+func TestTombstoneSurvivesStaleGossip(t *testing.T) {
+	r := NewRegistry()
+	srv := httptest.NewServer(r.Handler())
+	defer srv.Close()
+
+	pid := actor.NewPID("node-a", "worker/1")
+	resp := mustPost(t, srv, "/register", wireBinding{Node: "node-a", Name: "worker", PID: pid})
+	resp.Body.Close()
+
+	registered := whereIs(t, srv, "node-a", "worker")
+	if registered == nil {
+		t.Fatal("expected the registration to be visible before unregistering")
+	}
+
+	resp = mustPost(t, srv, "/unregister", wireBinding{Node: "node-a", Name: "worker"})
+	resp.Body.Close()
+
+	// A peer gossiping back the stale pre-delete binding must not win,
+	// since the tombstone carries a later UpdatedAt.
+	resp = mustPost(t, srv, "/gossip", []wireBinding{
+		{Node: "node-a", Name: "worker", PID: pid, UpdatedAt: registered.UpdatedAt, OriginID: registered.OriginID},
+	})
+	resp.Body.Close()
+
+	if got := whereIs(t, srv, "node-a", "worker"); got != nil {
+		t.Fatalf("whereis = %v, want nil: the tombstone must survive a stale gossip replay", got)
+	}
+}
@@ -0,0 +1,244 @@
+package actor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// nameRegistry tracks name -> PID bindings registered on this Engine, as
+// well as a cache of names resolved against a remote name server via
+// WhereIsRemote. Entries in both maps are dropped as soon as their PID
+// terminates, via the monitor/link subsystem's notifyTerminated hook;
+// that hook only runs for processes on this Engine, so a cached PID that
+// lives on another node is not dropped when it terminates there.
+type nameRegistry struct {
+	mu    sync.RWMutex
+	local map[string]*PID
+	cache map[string]*PID // keyed by "node/name"
+	// remoteByName tracks, for each bare name, the cache key of the most
+	// recently cached WhereIsRemote result for it (a lookup answered from
+	// the cache does not update it). It only ever backs up
+	// getByName's fallback path; a local registration of the same name
+	// always takes precedence and is never shadowed by it.
+	remoteByName map[string]string
+}
+
+func newNameRegistry() *nameRegistry {
+	return &nameRegistry{
+		local:        make(map[string]*PID),
+		cache:        make(map[string]*PID),
+		remoteByName: make(map[string]string),
+	}
+}
+
+func (nr *nameRegistry) get(name string) *PID {
+	nr.mu.RLock()
+	defer nr.mu.RUnlock()
+	return nr.local[name]
+}
+
+func (nr *nameRegistry) getCached(key string) *PID {
+	nr.mu.RLock()
+	defer nr.mu.RUnlock()
+	return nr.cache[key]
+}
+
+func (nr *nameRegistry) set(name string, pid *PID) bool {
+	nr.mu.Lock()
+	defer nr.mu.Unlock()
+	if _, exists := nr.local[name]; exists {
+		return false
+	}
+	nr.local[name] = pid
+	return true
+}
+
+func (nr *nameRegistry) setCached(key, name string, pid *PID) {
+	nr.mu.Lock()
+	defer nr.mu.Unlock()
+	nr.cache[key] = pid
+	nr.remoteByName[name] = key
+}
+
+func (nr *nameRegistry) remove(name string) {
+	nr.mu.Lock()
+	defer nr.mu.Unlock()
+	delete(nr.local, name)
+}
+
+// getByName resolves a bare name the way SendNamed does: a local
+// registration always wins, since it is unambiguous; only once there is
+// no local binding does it fall back to the most recently cached
+// WhereIsRemote result for that name, so a remote lookup can never
+// shadow a local registration of the same name.
+func (nr *nameRegistry) getByName(name string) *PID {
+	nr.mu.RLock()
+	defer nr.mu.RUnlock()
+	if pid, ok := nr.local[name]; ok {
+		return pid
+	}
+	if key, ok := nr.remoteByName[name]; ok {
+		return nr.cache[key]
+	}
+	return nil
+}
+
+// onTerminated drops every local binding and cache entry pointing at pid.
+// Called from notifyTerminated so stale names never resolve to a dead
+// process.
+func (nr *nameRegistry) onTerminated(pid *PID) {
+	nr.mu.Lock()
+	defer nr.mu.Unlock()
+	for name, bound := range nr.local {
+		if bound.Equals(pid) {
+			delete(nr.local, name)
+		}
+	}
+	for key, bound := range nr.cache {
+		if bound.Equals(pid) {
+			delete(nr.cache, key)
+			for name, k := range nr.remoteByName {
+				if k == key {
+					delete(nr.remoteByName, name)
+				}
+			}
+		}
+	}
+}
+
+// RegisterName binds name to pid on this Engine. Names are local by
+// default; once a Remoter and a name server address are configured via
+// EngineConfig.WithNameServer, the binding is also published to the
+// sidecar mapper so other nodes can resolve it through WhereIsRemote.
+func (e *Engine) RegisterName(name string, pid *PID) error {
+	if !e.names.set(name, pid) {
+		return fmt.Errorf("name %q is already registered", name)
+	}
+	if e.nameServerAddr != "" && e.remote != nil {
+		if err := publishName(e.nameServerAddr, e.address, name, pid); err != nil {
+			e.names.remove(name)
+			return fmt.Errorf("failed to publish name %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Unregister removes the binding for name, both locally and, if
+// configured, on the sidecar name server.
+func (e *Engine) Unregister(name string) {
+	e.names.remove(name)
+	if e.nameServerAddr != "" && e.remote != nil {
+		_ = unpublishName(e.nameServerAddr, e.address, name)
+	}
+}
+
+// WhereIs resolves name against this Engine's local name table. It does
+// not consult the name server or any cached remote lookups; use
+// WhereIsRemote for that.
+func (e *Engine) WhereIs(name string) *PID {
+	return e.names.get(name)
+}
+
+// WhereIsRemote resolves (node, name) against the sidecar name server
+// configured with EngineConfig.WithNameServer, caching the result. The
+// cache entry is dropped automatically once the resolved process
+// terminates on this Engine, via the same notifyTerminated hook used by
+// Engine.Monitor; a termination on another node does not drop it.
+func (e *Engine) WhereIsRemote(ctx context.Context, node, name string) (*PID, error) {
+	key := node + "/" + name
+	if pid := e.names.getCached(key); pid != nil {
+		return pid, nil
+	}
+	if e.nameServerAddr == "" {
+		return nil, fmt.Errorf("no name server configured on this engine")
+	}
+	pid, err := queryName(ctx, e.nameServerAddr, node, name)
+	if err != nil {
+		return nil, err
+	}
+	e.names.setCached(key, name, pid)
+	return pid, nil
+}
+
+// SendNamed resolves name, lazily, against the local name table or the
+// most recently cached WhereIsRemote result for that name, and sends msg
+// to it. If name cannot be resolved a DeadLetterEvent carrying msg, with
+// a nil Target, is broadcast over the eventstream.
+func (e *Engine) SendNamed(name string, msg any) {
+	if pid := e.names.getByName(name); pid != nil {
+		e.Send(pid, msg)
+		return
+	}
+	e.BroadcastEvent(DeadLetterEvent{Message: msg})
+}
+
+// mapperClient is the minimal HTTP client side of the qpmd-inspired
+// mapper protocol spoken between an Engine and a hollywood-namesrv
+// sidecar. See cmd/hollywood-namesrv for the server implementation.
+var mapperClient = &http.Client{Timeout: 5 * time.Second}
+
+type mapperBinding struct {
+	Node string `json:"node"`
+	Name string `json:"name"`
+	PID  *PID   `json:"pid,omitempty"`
+}
+
+func publishName(addr, node, name string, pid *PID) error {
+	body, err := json.Marshal(mapperBinding{Node: node, Name: name, PID: pid})
+	if err != nil {
+		return err
+	}
+	resp, err := mapperClient.Post("http://"+addr+"/register", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("name server returned %s", resp.Status)
+	}
+	return nil
+}
+
+func unpublishName(addr, node, name string) error {
+	body, err := json.Marshal(mapperBinding{Node: node, Name: name})
+	if err != nil {
+		return err
+	}
+	resp, err := mapperClient.Post("http://"+addr+"/unregister", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func queryName(ctx context.Context, addr, node, name string) (*PID, error) {
+	query := url.Values{"node": {node}, "name": {name}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("http://%s/whereis?%s", addr, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mapperClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("name %q not found on node %q", name, node)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("name server returned %s", resp.Status)
+	}
+	var binding mapperBinding
+	if err := json.NewDecoder(resp.Body).Decode(&binding); err != nil {
+		return nil, err
+	}
+	return binding.PID, nil
+}
@@ -0,0 +1,88 @@
+package actor
+
+import "testing"
+
+func TestRegisterNameAndWhereIs(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, _ := collector(t, e)
+
+	if err := e.RegisterName("worker", pid); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.WhereIs("worker"); !got.Equals(pid) {
+		t.Fatalf("WhereIs(\"worker\") = %v, want %v", got, pid)
+	}
+}
+
+func TestRegisterNameRejectsDuplicate(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := collector(t, e)
+	b, _ := collector(t, e)
+
+	if err := e.RegisterName("worker", a); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.RegisterName("worker", b); err == nil {
+		t.Fatal("expected registering an already-taken name to fail")
+	}
+}
+
+func TestUnregisterDropsName(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, _ := collector(t, e)
+	_ = e.RegisterName("worker", pid)
+
+	e.Unregister("worker")
+
+	if got := e.WhereIs("worker"); got != nil {
+		t.Fatalf("WhereIs(\"worker\") = %v, want nil after Unregister", got)
+	}
+}
+
+// TestNameInvalidatedOnTermination verifies that a name is dropped as
+// soon as its PID terminates, via the monitor subsystem's
+// ProcessTerminated hook, instead of going on resolving to a dead PID.
+func TestNameInvalidatedOnTermination(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, _ := collector(t, e)
+	_ = e.RegisterName("worker", pid)
+
+	<-e.Poison(pid).Done()
+
+	if got := e.WhereIs("worker"); got != nil {
+		t.Fatalf("WhereIs(\"worker\") = %v, want nil once the process has terminated", got)
+	}
+}
+
+// TestSendNamedPrefersLocalOverRemoteCache verifies a local registration
+// is never shadowed by a cached WhereIsRemote result for the same bare
+// name, even if the remote lookup happened more recently.
+func TestSendNamedPrefersLocalOverRemoteCache(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, localCh := collector(t, e)
+	_ = e.RegisterName("worker", local)
+
+	remote := NewPID("other-node", "worker/1")
+	e.names.setCached("other-node/worker", "worker", remote)
+
+	e.SendNamed("worker", "hi")
+	msg := expectMessage(t, localCh)
+	if msg != "hi" {
+		t.Fatalf("expected the local registration to win, got %#v delivered instead of to local", msg)
+	}
+}
@@ -0,0 +1,76 @@
+package actor
+
+// DefaultInboxSize is the default number of messages that can be buffered
+// in a process' inbox before Send starts blocking the caller.
+const DefaultInboxSize = 1024
+
+// DefaultMaxRestarts is the default value of Opts.MaxRestarts. Nothing in
+// this package restarts a process or reads MaxRestarts: a crashed process
+// stays dead.
+const DefaultMaxRestarts = 3
+
+// Opts holds the configuration used to spawn a process.
+type Opts struct {
+	Producer    Producer
+	Kind        string
+	ID          string
+	MaxRestarts int32
+	InboxSize   int
+
+	// TrapExit, when set, tells the monitor/link subsystem that this
+	// process wants to handle the termination of its linked peers itself
+	// (by receiving a ProcessTerminated message) instead of being crashed
+	// or stopped along with them.
+	TrapExit bool
+
+	// Middleware is composed, in order, around the process' Receive call
+	// when it is activated.
+	Middleware []ReceiveMiddleware
+	// ContextDecorator is applied, in order, to every Context before it
+	// reaches the middleware chain.
+	ContextDecorator []ContextDecorator
+}
+
+// OptFunc is a function that can configure an Opts struct, used when
+// spawning a process.
+type OptFunc func(*Opts)
+
+// DefaultOpts returns the default options for the given Producer.
+func DefaultOpts(p Producer) Opts {
+	return Opts{
+		Producer:    p,
+		MaxRestarts: DefaultMaxRestarts,
+		InboxSize:   DefaultInboxSize,
+	}
+}
+
+// WithID sets a custom ID for the process being spawned. If not given, a
+// random ID is generated.
+func WithID(id string) OptFunc {
+	return func(opts *Opts) {
+		opts.ID = id
+	}
+}
+
+// WithInboxSize sets the inbox size of the process being spawned.
+func WithInboxSize(size int) OptFunc {
+	return func(opts *Opts) {
+		opts.InboxSize = size
+	}
+}
+
+// WithMaxRestarts sets the maximum amount of restarts for the process
+// being spawned.
+func WithMaxRestarts(n int32) OptFunc {
+	return func(opts *Opts) {
+		opts.MaxRestarts = n
+	}
+}
+
+// WithTrapExit makes the spawned process receive a ProcessTerminated
+// message instead of being shut down when a linked peer terminates.
+func WithTrapExit(trap bool) OptFunc {
+	return func(opts *Opts) {
+		opts.TrapExit = trap
+	}
+}
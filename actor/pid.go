@@ -0,0 +1,50 @@
+package actor
+
+import "fmt"
+
+// LocalLookupAddr is the address used for an Engine that has no Remoter
+// configured.
+const LocalLookupAddr = "local"
+
+// PID, or Process Identifier, is a unique identifier that can be used to
+// reach a given process, either locally or remotely.
+type PID struct {
+	Address string
+	ID      string
+}
+
+// NewPID returns a new PID given an address and an id.
+func NewPID(address, id string) *PID {
+	return &PID{
+		Address: address,
+		ID:      id,
+	}
+}
+
+// String returns a human readable representation of the PID, in the
+// form of "address/id".
+func (pid *PID) String() string {
+	if pid == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s/%s", pid.Address, pid.ID)
+}
+
+// Equals returns true if both PIDs point at the same address/id pair.
+func (pid *PID) Equals(other *PID) bool {
+	if pid == nil || other == nil {
+		return pid == other
+	}
+	return pid.Address == other.Address && pid.ID == other.ID
+}
+
+// CloneVT returns a deep copy of the PID. It is named after the vtprotobuf
+// convention used elsewhere so remote transports can treat PID like any
+// other wire message.
+func (pid *PID) CloneVT() *PID {
+	if pid == nil {
+		return nil
+	}
+	clone := *pid
+	return &clone
+}
@@ -0,0 +1,197 @@
+package actor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Envelope wraps a message together with the PID of whoever sent it, if
+// any.
+type Envelope struct {
+	Msg    any
+	Sender *PID
+}
+
+// poisonPill is the internal message sent by Stop/Poison/PoisonCtx to
+// tell a process to shut down. graceful records whether it came from
+// Poison/PoisonCtx (true) or Stop (false); the process handles both
+// alike: the pill is queued behind the messages already in the inbox,
+// and once it is reached the process stops without handling anything
+// queued after it. cancel is called once the process has finished
+// handling it, to unblock whoever is waiting on the context returned by
+// those methods.
+type poisonPill struct {
+	cancel   context.CancelFunc
+	graceful bool
+}
+
+// Processer is the interface the Registry deals with. Besides the default
+// process implementation below, Response also implements Processer so it
+// can be registered and addressed like any other actor.
+type Processer interface {
+	PID() *PID
+	Send(pid *PID, msg any, sender *PID)
+	Shutdown(wg *sync.WaitGroup)
+}
+
+// process is the default Processer, backing every actor spawned through
+// Engine.Spawn.
+type process struct {
+	Opts
+
+	engine   *Engine
+	pid      *PID
+	context  *Context
+	receiver Receiver
+	handler  func(*Context)
+	inbox    chan Envelope
+	// saved holds messages that were skipped by a ReceiveSelected call.
+	// It is only ever touched from the process' own goroutine.
+	saved []Envelope
+	done  chan struct{}
+	wg    sync.WaitGroup
+
+	// ctx is cancelled as soon as the process exits, letting goroutines
+	// started from Receive select on Context.Done().
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newProcess(e *Engine, opts Opts) *process {
+	pid := NewPID(e.address, opts.Kind+"/"+opts.ID)
+	p := &process{
+		Opts:   opts,
+		engine: e,
+		pid:    pid,
+		inbox:  make(chan Envelope, opts.InboxSize),
+		done:   make(chan struct{}),
+	}
+	p.context = newContext(e, pid)
+	p.context.proc = p
+	return p
+}
+
+// PID returns the PID associated with this process.
+func (p *process) PID() *PID { return p.pid }
+
+// Send delivers the given message to the process' inbox. The pid argument
+// is unused for local processes, it is part of the Processer interface so
+// Remoters can satisfy it too.
+func (p *process) Send(_ *PID, msg any, sender *PID) {
+	select {
+	case p.inbox <- Envelope{Msg: msg, Sender: sender}:
+	case <-p.done:
+	}
+}
+
+func (p *process) start() {
+	p.receiver = p.Producer()
+	p.handler = buildHandler(p.receiver.Receive, p.engine.middleware, p.Middleware)
+	p.wg.Add(1)
+	go p.run()
+}
+
+func (p *process) run() {
+	defer p.wg.Done()
+	defer p.cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			p.engine.handleProcessCrash(p.pid, r)
+		}
+	}()
+	for {
+		env, ok := p.nextEnvelope()
+		if !ok {
+			return
+		}
+		if pill, ok := env.Msg.(poisonPill); ok {
+			p.handlePoisonPill(pill)
+			return
+		}
+		p.deliver(env)
+	}
+}
+
+// nextEnvelope returns the next message the process should handle,
+// preferring anything left over on the saved queue from a previous
+// ReceiveSelected call over new messages arriving on the inbox.
+func (p *process) nextEnvelope() (Envelope, bool) {
+	if len(p.saved) > 0 {
+		env := p.saved[0]
+		p.saved = p.saved[1:]
+		return env, true
+	}
+	select {
+	case env := <-p.inbox:
+		return env, true
+	case <-p.done:
+		return Envelope{}, false
+	}
+}
+
+func (p *process) deliver(env Envelope) {
+	p.context.message = env.Msg
+	p.context.sender = env.Sender
+	c := p.context
+	for _, dec := range p.ContextDecorator {
+		c = dec(c)
+	}
+	p.handler(c)
+}
+
+// receiveSelected implements Context.ReceiveSelected. It first checks the
+// saved queue for a match before waiting on new inbox messages, so a
+// message stashed by an earlier selective receive can still be picked up.
+func (p *process) receiveSelected(ctx context.Context, sel MessageSelector) (any, error) {
+	for i, env := range p.saved {
+		if _, ok := env.Msg.(poisonPill); ok {
+			return nil, fmt.Errorf("%s: process stopped", p.pid)
+		}
+		if sel(env.Msg) {
+			p.saved = append(p.saved[:i:i], p.saved[i+1:]...)
+			return env.Msg, nil
+		}
+	}
+
+	var skipped []Envelope
+	defer func() {
+		p.saved = append(p.saved, skipped...)
+	}()
+	for {
+		var env Envelope
+		select {
+		case env = <-p.inbox:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-p.done:
+			return nil, fmt.Errorf("%s: process stopped", p.pid)
+		}
+		if _, ok := env.Msg.(poisonPill); ok {
+			// Stash the pill so the run loop handles it once Receive returns.
+			skipped = append(skipped, env)
+			return nil, fmt.Errorf("%s: process stopped", p.pid)
+		}
+		if sel(env.Msg) {
+			return env.Msg, nil
+		}
+		skipped = append(skipped, env)
+	}
+}
+
+func (p *process) handlePoisonPill(pill poisonPill) {
+	defer pill.cancel()
+	p.engine.handleProcessStopped(p.pid, pill.graceful)
+}
+
+// Shutdown stops the process, closing its inbox and notifying wg once the
+// internal goroutine has returned.
+func (p *process) Shutdown(wg *sync.WaitGroup) {
+	close(p.done)
+	go func() {
+		p.wg.Wait()
+		if wg != nil {
+			wg.Done()
+		}
+	}()
+}
@@ -0,0 +1,94 @@
+package actor
+
+import "sync"
+
+// compactThreshold bounds how much dead weight order is allowed to carry
+// before remove compacts it, so a long-running Engine that creates many
+// short-lived processes (Responses, scheduled sends, chans actors) does
+// not leak one string per registration for its entire lifetime.
+const compactThreshold = 256
+
+// Registry holds every Processer that is currently alive on an Engine,
+// keyed by the string representation of its PID.
+type Registry struct {
+	engine *Engine
+	mu     sync.RWMutex
+	procs  map[string]Processer
+	// order tracks the sequence in which PIDs were added, so the Engine
+	// can unwind them in reverse spawn order on Shutdown. remove compacts
+	// out dead entries once their count passes compactThreshold, rather
+	// than letting the slice grow unbounded.
+	order []string
+	dead  int
+}
+
+func newRegistry(e *Engine) *Registry {
+	return &Registry{
+		engine: e,
+		procs:  make(map[string]Processer),
+	}
+}
+
+// add registers the given Processer and starts it if it is a locally
+// spawned process.
+func (r *Registry) add(p Processer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := p.PID().String()
+	r.procs[key] = p
+	r.order = append(r.order, key)
+	if proc, ok := p.(*process); ok {
+		proc.start()
+	}
+}
+
+// get returns the Processer registered under the given PID, or nil if
+// none is found.
+func (r *Registry) get(pid *PID) Processer {
+	if pid == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.procs[pid.String()]
+}
+
+// remove removes the Processer registered under the given PID from the
+// registry. It does not stop the process; callers are expected to have
+// done so already.
+func (r *Registry) remove(pid *PID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.procs, pid.String())
+	r.dead++
+	if r.dead > compactThreshold {
+		r.compactLocked()
+	}
+}
+
+// compactLocked rebuilds order with the dead entries dropped, preserving
+// the relative spawn order of what remains. Callers must hold r.mu.
+func (r *Registry) compactLocked() {
+	live := make([]string, 0, len(r.order)-r.dead)
+	for _, key := range r.order {
+		if _, ok := r.procs[key]; ok {
+			live = append(live, key)
+		}
+	}
+	r.order = live
+	r.dead = 0
+}
+
+// pids returns every PID currently known to the registry, in reverse
+// spawn order (most recently spawned first).
+func (r *Registry) pids() []*PID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pids := make([]*PID, 0, len(r.order))
+	for i := len(r.order) - 1; i >= 0; i-- {
+		if p, ok := r.procs[r.order[i]]; ok {
+			pids = append(pids, p.PID())
+		}
+	}
+	return pids
+}
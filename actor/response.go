@@ -0,0 +1,62 @@
+package actor
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Response is a short lived Processer used by Engine.Request to receive
+// exactly one reply and hand it back to the caller of Result().
+type Response struct {
+	pid     *PID
+	engine  *Engine
+	result  chan any
+	timeout time.Duration
+}
+
+// NewResponse returns a new Response that will be registered on the given
+// Engine and times out after the given duration.
+func NewResponse(e *Engine, timeout time.Duration) *Response {
+	return &Response{
+		pid:     NewPID(e.address, "response/"+strconv.Itoa(rand.Intn(math.MaxInt))),
+		engine:  e,
+		result:  make(chan any, 1),
+		timeout: timeout,
+	}
+}
+
+// PID returns the PID of the response process.
+func (r *Response) PID() *PID { return r.pid }
+
+// Send delivers the reply to the waiting Result() call. Only the first
+// reply is kept; the response is deregistered from the Engine by Result.
+func (r *Response) Send(_ *PID, msg any, _ *PID) {
+	select {
+	case r.result <- msg:
+	default:
+	}
+}
+
+// Shutdown implements Processer so Response can be cleaned up the same
+// way any other process is.
+func (r *Response) Shutdown(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+}
+
+// Result blocks until either a reply arrives or the configured timeout
+// elapses.
+func (r *Response) Result() (any, error) {
+	defer r.engine.Registry.remove(r.pid)
+	select {
+	case res := <-r.result:
+		return res, nil
+	case <-time.After(r.timeout):
+		return nil, fmt.Errorf("response timed out after %s", r.timeout)
+	}
+}
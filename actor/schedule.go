@@ -0,0 +1,103 @@
+package actor
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Schedule decides when a send registered with Engine.ScheduleSend
+// should fire next, given the last time it fired (the zero Time if it
+// has never fired yet). Returning the zero Time tells the scheduler the
+// schedule is exhausted and the send should not be rescheduled.
+type Schedule interface {
+	Next(last time.Time) time.Time
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// FixedInterval fires every D, optionally smeared by up to Jitter to
+// avoid many schedules firing in lockstep.
+type FixedInterval struct {
+	D      time.Duration
+	Jitter time.Duration
+}
+
+// Next implements Schedule.
+func (s FixedInterval) Next(last time.Time) time.Time {
+	if last.IsZero() {
+		last = time.Now()
+	}
+	return last.Add(s.D).Add(jitter(s.Jitter))
+}
+
+// ExponentialBackoff fires with a delay that starts at Initial and grows
+// by Factor on every fire, capped at Max, optionally smeared by up to
+// Jitter. It carries state between calls to Next, so a single
+// ExponentialBackoff value must not be shared between two ScheduleSend
+// calls; pass a pointer so the scheduler mutates your instance directly.
+type ExponentialBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+	Jitter  time.Duration
+
+	current time.Duration
+}
+
+// Next implements Schedule.
+func (s *ExponentialBackoff) Next(last time.Time) time.Time {
+	switch {
+	case s.current == 0:
+		s.current = s.Initial
+	default:
+		s.current = time.Duration(float64(s.current) * s.Factor)
+		if s.Max > 0 && s.current > s.Max {
+			s.current = s.Max
+		}
+	}
+	if last.IsZero() {
+		last = time.Now()
+	}
+	return last.Add(s.current).Add(jitter(s.Jitter))
+}
+
+// Cron fires according to a standard 5-field cron expression
+// ("minute hour day-of-month month day-of-week"), evaluated in local
+// time.
+type Cron struct {
+	Expr string
+
+	parsed *cronExpr
+}
+
+// Next implements Schedule.
+func (s *Cron) Next(last time.Time) time.Time {
+	if s.parsed == nil {
+		parsed, err := parseCron(s.Expr)
+		if err != nil {
+			return time.Time{}
+		}
+		s.parsed = parsed
+	}
+	if last.IsZero() {
+		last = time.Now()
+	}
+	return s.parsed.next(last)
+}
+
+// OneShotAt fires exactly once, at the given time, and is then
+// exhausted.
+type OneShotAt time.Time
+
+// Next implements Schedule.
+func (s OneShotAt) Next(last time.Time) time.Time {
+	if !last.IsZero() {
+		return time.Time{}
+	}
+	return time.Time(s)
+}
@@ -0,0 +1,250 @@
+package actor
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// scheduleEntry is one send registered through Engine.ScheduleSend.
+type scheduleEntry struct {
+	mu       sync.Mutex
+	pid      *PID
+	msg      any
+	schedule Schedule
+	last     time.Time
+	next     time.Time
+	paused   bool
+	dead     bool
+	index    int // position in the scheduler's heap, -1 when not queued
+}
+
+// scheduleHeap orders scheduleEntry by next fire time and implements
+// container/heap.Interface.
+type scheduleHeap []*scheduleEntry
+
+func (h scheduleHeap) Len() int { return len(h) }
+func (h scheduleHeap) Less(i, j int) bool {
+	return h[i].next.Before(h[j].next)
+}
+func (h scheduleHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *scheduleHeap) Push(x any) {
+	entry := x.(*scheduleEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+func (h *scheduleHeap) Pop() any {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.index = -1
+	*h = old[:n-1]
+	return entry
+}
+
+// scheduler fires every ScheduleSend registered on an Engine from a
+// single goroutine, ordering them on a heap by next fire time instead of
+// running one goroutine and ticker per repeat.
+type scheduler struct {
+	engine *Engine
+	mu     sync.Mutex
+	items  scheduleHeap
+	wake   chan struct{}
+	done   chan struct{}
+}
+
+func newScheduler(e *Engine) *scheduler {
+	s := &scheduler{
+		engine: e,
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *scheduler) stop() {
+	close(s.done)
+}
+
+func (s *scheduler) schedule(pid *PID, msg any, sc Schedule) *scheduleEntry {
+	entry := &scheduleEntry{
+		pid:      pid,
+		msg:      msg,
+		schedule: sc,
+		index:    -1,
+	}
+	entry.next = sc.Next(time.Time{})
+	if entry.next.IsZero() {
+		entry.dead = true
+		return entry
+	}
+	s.push(entry)
+	return entry
+}
+
+func (s *scheduler) push(entry *scheduleEntry) {
+	s.mu.Lock()
+	heap.Push(&s.items, entry)
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *scheduler) remove(entry *scheduleEntry) {
+	entry.mu.Lock()
+	entry.dead = true
+	entry.mu.Unlock()
+
+	s.mu.Lock()
+	if entry.index >= 0 {
+		heap.Remove(&s.items, entry.index)
+	}
+	s.mu.Unlock()
+}
+
+func (s *scheduler) pause(entry *scheduleEntry) {
+	entry.mu.Lock()
+	entry.paused = true
+	entry.mu.Unlock()
+
+	s.mu.Lock()
+	if entry.index >= 0 {
+		heap.Remove(&s.items, entry.index)
+	}
+	s.mu.Unlock()
+}
+
+func (s *scheduler) resume(entry *scheduleEntry) {
+	entry.mu.Lock()
+	if !entry.paused || entry.dead {
+		entry.mu.Unlock()
+		return
+	}
+	entry.paused = false
+	entry.next = entry.schedule.Next(time.Time{})
+	dead := entry.next.IsZero()
+	entry.dead = dead
+	entry.mu.Unlock()
+
+	if !dead {
+		s.push(entry)
+	}
+}
+
+func (s *scheduler) run() {
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+	for {
+		timer.Reset(s.nextWait())
+		select {
+		case <-s.wake:
+		case <-timer.C:
+			s.fireDue()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *scheduler) nextWait() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items) == 0 {
+		return time.Hour
+	}
+	d := time.Until(s.items[0].next)
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func (s *scheduler) fireDue() {
+	now := time.Now()
+	var due []*scheduleEntry
+	s.mu.Lock()
+	for len(s.items) > 0 && !s.items[0].next.After(now) {
+		due = append(due, heap.Pop(&s.items).(*scheduleEntry))
+	}
+	s.mu.Unlock()
+
+	for _, entry := range due {
+		entry.mu.Lock()
+		if entry.dead {
+			entry.mu.Unlock()
+			continue
+		}
+		pid, msg := entry.pid, entry.msg
+		entry.last = now
+		next := entry.schedule.Next(entry.last)
+		entry.next = next
+		entry.dead = next.IsZero()
+		dead := entry.dead
+		entry.mu.Unlock()
+
+		// Fires route through the normal send path, so the Remoter
+		// delivers scheduled messages to remote PIDs correctly.
+		s.engine.SendWithSender(pid, msg, nil)
+
+		if !dead {
+			s.push(entry)
+		}
+	}
+}
+
+// ScheduleHandle controls a send previously registered with
+// Engine.ScheduleSend.
+type ScheduleHandle struct {
+	entry *scheduleEntry
+	sched *scheduler
+}
+
+// Stop cancels the scheduled send. It is a no-op if already stopped.
+func (h ScheduleHandle) Stop() {
+	h.sched.remove(h.entry)
+}
+
+// Pause temporarily stops the schedule from firing; call Resume to pick
+// it back up. The pending fire time is dropped and Resume computes a new
+// one.
+func (h ScheduleHandle) Pause() {
+	h.sched.pause(h.entry)
+}
+
+// Resume restarts a paused schedule, computing its next fire time from
+// now by calling the schedule's Next with the zero Time. Stateful
+// schedules keep their state: an ExponentialBackoff goes on from the
+// delay it had reached rather than from Initial.
+func (h ScheduleHandle) Resume() {
+	h.sched.resume(h.entry)
+}
+
+// Next returns the next time this schedule is due to fire.
+func (h ScheduleHandle) Next() time.Time {
+	h.entry.mu.Lock()
+	defer h.entry.mu.Unlock()
+	return h.entry.next
+}
+
+// ScheduleSend arranges for msg to be sent to pid every time sc fires,
+// until sc is exhausted (Next returns the zero Time) or the returned
+// ScheduleHandle is stopped. Every schedule registered on an Engine is
+// driven by one internal scheduler goroutine ordered on a heap by next
+// fire time, rather than a goroutine and ticker per schedule.
+func (e *Engine) ScheduleSend(pid *PID, msg any, sc Schedule) ScheduleHandle {
+	clonedPID := *pid.CloneVT()
+	entry := e.scheduler.schedule(&clonedPID, msg, sc)
+	return ScheduleHandle{entry: entry, sched: e.scheduler}
+}
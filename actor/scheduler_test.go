@@ -0,0 +1,78 @@
+package actor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleSendFixedInterval(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, ch := collector(t, e)
+
+	handle := e.ScheduleSend(pid, "tick", FixedInterval{D: 20 * time.Millisecond})
+	expectMessage(t, ch)
+	expectMessage(t, ch)
+
+	handle.Stop()
+	drainChan(ch)
+	expectNoMessage(t, ch)
+}
+
+func TestScheduleHandlePauseResume(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, ch := collector(t, e)
+
+	handle := e.ScheduleSend(pid, "tick", FixedInterval{D: 20 * time.Millisecond})
+	expectMessage(t, ch)
+
+	handle.Pause()
+	drainChan(ch)
+	expectNoMessage(t, ch)
+
+	handle.Resume()
+	expectMessage(t, ch)
+	handle.Stop()
+}
+
+func TestScheduleSendOneShot(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, ch := collector(t, e)
+
+	e.ScheduleSend(pid, "once", OneShotAt(time.Now().Add(10*time.Millisecond)))
+	expectMessage(t, ch)
+	expectNoMessage(t, ch)
+}
+
+func TestScheduleHandleNext(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, _ := collector(t, e)
+
+	before := time.Now()
+	handle := e.ScheduleSend(pid, "tick", FixedInterval{D: time.Hour})
+	if !handle.Next().After(before) {
+		t.Fatalf("expected Next() to be in the future, got %v", handle.Next())
+	}
+	handle.Stop()
+}
+
+func drainChan(ch chan any) {
+	for {
+		select {
+		case <-ch:
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package actor
+
+// MessageSelector decides whether a message pending in a process' inbox
+// should be consumed by a Context.ReceiveSelected call.
+type MessageSelector func(msg any) bool
+
+// SelectAny matches every message. It is mostly useful as a readable way
+// to say "receive whatever is next" through the same API as a selective
+// receive.
+func SelectAny() MessageSelector {
+	return func(any) bool { return true }
+}
+
+// SelectType returns a MessageSelector that matches messages whose
+// dynamic type is T or, when T is an interface type, implements T.
+func SelectType[T any]() MessageSelector {
+	return func(msg any) bool {
+		_, ok := msg.(T)
+		return ok
+	}
+}
+
+// SelectWith returns a MessageSelector that matches messages of type T
+// for which fn returns true.
+func SelectWith[T any](fn func(T) bool) MessageSelector {
+	return func(msg any) bool {
+		v, ok := msg.(T)
+		if !ok {
+			return false
+		}
+		return fn(v)
+	}
+}
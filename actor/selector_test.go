@@ -0,0 +1,72 @@
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestReceiveSelectedSavedQueueReordering verifies that messages skipped
+// by a ReceiveSelected call are stashed and handed back to the process,
+// in their original arrival order, once the selective receive completes.
+func TestReceiveSelectedSavedQueueReordering(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := make(chan any, 16)
+
+	pid := e.SpawnFunc(func(c *Context) {
+		if msg, ok := c.Message().(string); ok && msg == "start" {
+			v, err := c.ReceiveSelected(context.Background(), SelectType[int]())
+			if err != nil {
+				results <- err
+				return
+			}
+			results <- v
+			return
+		}
+		results <- c.Message()
+	}, "selective")
+
+	e.Send(pid, "start")
+	e.Send(pid, "noise-a")
+	e.Send(pid, 42)
+	e.Send(pid, "noise-b")
+
+	want := []any{42, "noise-a", "noise-b"}
+	for _, w := range want {
+		got := expectMessage(t, results)
+		if got != w {
+			t.Fatalf("got %#v, want %#v", got, w)
+		}
+	}
+}
+
+// TestReceiveSelectedTimesOutOnCtx verifies ReceiveSelected returns the
+// context's error, instead of blocking forever, when nothing matching
+// ever arrives.
+func TestReceiveSelectedTimesOutOnCtx(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := make(chan any, 1)
+
+	pid := e.SpawnFunc(func(c *Context) {
+		if msg, ok := c.Message().(string); ok && msg == "start" {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			_, err := c.ReceiveSelected(ctx, SelectType[int]())
+			results <- err
+		}
+	}, "selective-timeout")
+
+	e.Send(pid, "start")
+	e.Send(pid, "never an int")
+
+	got := expectMessage(t, results)
+	if got != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %#v", got)
+	}
+}
@@ -0,0 +1,94 @@
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestShutdownStopsAllProcesses(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pids []*PID
+	for i := 0; i < 5; i++ {
+		pid, _ := collector(t, e)
+		pids = append(pids, pid)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown returned an error: %v", err)
+	}
+
+	for _, pid := range pids {
+		if e.Registry.get(pid) != nil {
+			t.Fatalf("process %s is still registered after Shutdown", pid)
+		}
+	}
+}
+
+func TestShutdownRejectsNewSends(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, ch := collector(t, e)
+
+	if err := e.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown returned an error: %v", err)
+	}
+
+	e.Send(pid, "too late")
+	expectNoMessage(t, ch)
+}
+
+func TestShutdownTwiceReturnsError(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Shutdown(context.Background()); err != nil {
+		t.Fatalf("first Shutdown returned an error: %v", err)
+	}
+	if err := e.Shutdown(context.Background()); err == nil {
+		t.Fatal("expected the second Shutdown call to return an error")
+	}
+}
+
+// TestShutdownEscalatesOnCtxCancellation verifies that a process which
+// never finishes draining its inbox is hard-stopped once ctx is done,
+// instead of hanging Shutdown forever.
+func TestShutdownEscalatesOnCtxCancellation(t *testing.T) {
+	e, err := NewEngine(NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockForever := make(chan struct{})
+	pid := e.SpawnFunc(func(c *Context) {
+		if _, ok := c.Message().(string); ok {
+			<-blockForever
+		}
+	}, "blocker")
+	e.Send(pid, "block")
+	// Give the process a moment to pick up the blocking message before
+	// Shutdown tries to poison it.
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- e.Shutdown(ctx) }()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected Shutdown to report the context's cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown did not escalate to a hard Stop once ctx was cancelled")
+	}
+}
@@ -0,0 +1,36 @@
+// Command hollywood-namesrv runs a standalone instance of the gossiping
+// name server sidecar that Engines talk to via
+// EngineConfig.WithNameServer, so services can be looked up by name
+// across a cluster without hardcoding each node's address.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/pnkj-kmr/hollywood/actor/namesrv"
+)
+
+func main() {
+	var (
+		addr  = flag.String("addr", ":4242", "address to listen on")
+		peers = flag.String("peers", "", "comma separated list of peer namesrv addresses to gossip with")
+	)
+	flag.Parse()
+
+	var peerList []string
+	if *peers != "" {
+		peerList = strings.Split(*peers, ",")
+	}
+
+	registry := namesrv.NewRegistry(peerList...)
+
+	stop := make(chan struct{})
+	go registry.Run(stop)
+	defer close(stop)
+
+	log.Printf("hollywood-namesrv listening on %s, gossiping with %v", *addr, peerList)
+	log.Fatal(http.ListenAndServe(*addr, registry.Handler()))
+}